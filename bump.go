@@ -0,0 +1,73 @@
+package gover
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Returns a new version with the major segment incremented and the minor,
+// patch, prerelease and build parts reset.
+func (v *Version) IncMajor() *Version {
+	return buildVersion(v.Major()+1, 0, 0, nil, nil)
+}
+
+// Returns a new version with the minor segment incremented and the patch,
+// prerelease and build parts reset.
+func (v *Version) IncMinor() *Version {
+	return buildVersion(v.Major(), v.Minor()+1, 0, nil, nil)
+}
+
+// Returns a new version with the patch segment incremented and the
+// prerelease and build parts reset.
+func (v *Version) IncPatch() *Version {
+	return buildVersion(v.Major(), v.Minor(), v.Patch()+1, nil, nil)
+}
+
+// Returns a new version with the given prerelease tag (e.g. "rc.1") and the
+// build metadata dropped.
+func (v *Version) WithPrerelease(prerelease string) (*Version, error) {
+	if prerelease == "" {
+		return nil, fmt.Errorf("prerelease must not be empty")
+	}
+	return buildVersion(v.Major(), v.Minor(), v.Patch(), parsePrerelease(prerelease), nil), nil
+}
+
+// Returns a new version with the given build metadata (e.g. "build.5").
+func (v *Version) WithBuild(build string) (*Version, error) {
+	if build == "" {
+		return nil, fmt.Errorf("build must not be empty")
+	}
+	return buildVersion(v.Major(), v.Minor(), v.Patch(), v.Prerelease, parseBuild(build)), nil
+}
+
+// Returns a new version with the prerelease tag removed, keeping any build metadata.
+func (v *Version) WithoutPrerelease() *Version {
+	return buildVersion(v.Major(), v.Minor(), v.Patch(), nil, v.Build)
+}
+
+// Strips the prerelease and build metadata, producing the associated GA version.
+func (v *Version) FinalizeRelease() *Version {
+	return buildVersion(v.Major(), v.Minor(), v.Patch(), nil, nil)
+}
+
+// Builds a new version from segments, regenerating Raw in canonical
+// "M.m.p[-pre][+build]" form.
+func buildVersion(major int, minor int, patch int, prerelease []PrereleaseIdent, build []string) *Version {
+	raw := fmt.Sprintf("%d.%d.%d", major, minor, patch)
+	if len(prerelease) > 0 {
+		raw += "-" + joinPrerelease(prerelease)
+	}
+	if len(build) > 0 {
+		raw += "+" + strings.Join(build, ".")
+	}
+	return &Version{
+		Raw: raw,
+		Segments: []VersionSegment{
+			{Number: major},
+			{Number: minor},
+			{Number: patch},
+		},
+		Prerelease: prerelease,
+		Build:      build,
+	}
+}
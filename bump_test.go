@@ -0,0 +1,33 @@
+package gover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBumpHelpers(t *testing.T) {
+	assert := assert.New(t)
+
+	version := MustParseVersionFromRegex("1.2.3-rc.1+build", RegexpSemver)
+
+	assert.Equal("2.0.0", version.IncMajor().Raw)
+	assert.Equal("1.3.0", version.IncMinor().Raw)
+	assert.Equal("1.2.4", version.IncPatch().Raw)
+
+	withPre, err := version.FinalizeRelease().WithPrerelease("beta.2")
+	assert.NoError(err)
+	assert.Equal("1.2.3-beta.2", withPre.Raw)
+
+	withBuild, err := version.FinalizeRelease().WithBuild("ci.5")
+	assert.NoError(err)
+	assert.Equal("1.2.3+ci.5", withBuild.Raw)
+
+	assert.Equal("1.2.3+build", version.WithoutPrerelease().Raw)
+	assert.Equal("1.2.3", version.FinalizeRelease().Raw)
+
+	_, err = version.WithPrerelease("")
+	assert.Error(err)
+	_, err = version.WithBuild("")
+	assert.Error(err)
+}
@@ -0,0 +1,201 @@
+package gover
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// A regex that matches a single constraint clause, e.g. ">=1.2.3" or "~>1.2".
+// Allows optional whitespace around the operator and an optional leading v.
+var constraintRegexp *regexp.Regexp = regexp.MustCompile(`^\s*(?P<op>!=|>=|<=|~>|\^|~|=|>|<)?\s*[vV]?(?P<ver>\d+(?:\.\d+){0,2})\s*$`)
+
+// A single comparison clause, e.g. the ">=1.2.3" in ">=1.2.3,<2.0.0".
+type Constraint struct {
+	Operator string
+	Version  *Version
+}
+
+// A set of constraints that all have to match (AND), joined with ",".
+type ConstraintSet []*Constraint
+
+// A list of constraint sets of which at least one has to match (OR), joined with "||".
+type Constraints []ConstraintSet
+
+// Parses a single constraint clause like ">=1.2.3" or "~>1.2".
+func ParseConstraint(constraintString string) (*Constraint, error) {
+	match := findNamedMatches(constraintRegexp, constraintString, true)
+	if match == nil {
+		return nil, fmt.Errorf("failed parsing the constraint %s: %w", constraintString, ErrNoMatch)
+	}
+	operator := match["op"]
+	if operator == "" {
+		operator = "="
+	}
+	version, err := ParseVersionFromRegex(match["ver"], RegexpSimple)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing the constraint %s: %w", constraintString, err)
+	}
+	return &Constraint{Operator: operator, Version: version}, nil
+}
+
+// Parses a comma-separated list of constraint clauses that all have to match (AND).
+func ParseConstraintSet(constraintString string) (ConstraintSet, error) {
+	parts := strings.Split(constraintString, ",")
+	set := make(ConstraintSet, len(parts))
+	for i, part := range parts {
+		constraint, err := ParseConstraint(part)
+		if err != nil {
+			return nil, err
+		}
+		set[i] = constraint
+	}
+	return set, nil
+}
+
+// Parses a "||"-separated list of constraint sets of which at least one has to match (OR).
+func ParseConstraints(constraintString string) (Constraints, error) {
+	parts := strings.Split(constraintString, "||")
+	constraints := make(Constraints, len(parts))
+	for i, part := range parts {
+		set, err := ParseConstraintSet(part)
+		if err != nil {
+			return nil, err
+		}
+		constraints[i] = set
+	}
+	return constraints, nil
+}
+
+// Checks whether the given version satisfies the constraint.
+func (c *Constraint) Check(v *Version) bool {
+	switch c.Operator {
+	case "=":
+		return v.CompareTo(c.Version) == 0
+	case "!=":
+		return v.CompareTo(c.Version) != 0
+	case ">":
+		return v.CompareTo(c.Version) > 0
+	case ">=":
+		return v.CompareTo(c.Version) >= 0
+	case "<":
+		return v.CompareTo(c.Version) < 0
+	case "<=":
+		return v.CompareTo(c.Version) <= 0
+	case "~>":
+		return checkRange(v, c.Version, pessimisticUpperBound(c.Version))
+	case "~":
+		return checkRange(v, c.Version, tildeUpperBound(c.Version))
+	case "^":
+		return checkRange(v, c.Version, caretUpperBound(c.Version))
+	default:
+		return false
+	}
+}
+
+// Converts the constraint back to a readable string, e.g. ">=1.2.3".
+func (c *Constraint) String() string {
+	return c.Operator + c.Version.Raw
+}
+
+// Checks whether the given version satisfies all constraints of the set.
+func (cs ConstraintSet) Check(v *Version) bool {
+	for _, c := range cs {
+		if !c.Check(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Converts the constraint set back to a readable string, e.g. ">=1.2.3,<2.0.0".
+func (cs ConstraintSet) String() string {
+	parts := make([]string, len(cs))
+	for i, c := range cs {
+		parts[i] = c.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+// Checks whether the given version satisfies at least one of the constraint sets.
+func (cs Constraints) Check(v *Version) bool {
+	for _, set := range cs {
+		if set.Check(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Converts the constraints back to a readable string, e.g. ">=1.2.3||<1.0.0".
+func (cs Constraints) String() string {
+	parts := make([]string, len(cs))
+	for i, set := range cs {
+		parts[i] = set.String()
+	}
+	return strings.Join(parts, "||")
+}
+
+// Filters the given versions down to those matching the constraint.
+func FindMatching(versions []*Version, c *Constraint) []*Version {
+	matched := []*Version{}
+	for _, v := range versions {
+		if c.Check(v) {
+			matched = append(matched, v)
+		}
+	}
+	return matched
+}
+
+// Counts the number of leading, explicitly given segments of a version,
+// e.g. 2 for a version parsed from "1.2".
+func definedPrecision(v *Version) int {
+	count := 0
+	for _, segment := range v.Segments {
+		if segment.IsNotDefined {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+func checkRange(v *Version, lower *Version, upper *Version) bool {
+	return v.CompareTo(lower) >= 0 && v.CompareTo(upper) < 0
+}
+
+// Computes the exclusive upper bound for the pessimistic "~>" operator:
+// bumps the minor version if a patch was given, otherwise the major version.
+func pessimisticUpperBound(v *Version) *Version {
+	if definedPrecision(v) >= 3 {
+		return ParseSimple(v.Major(), v.Minor()+1, 0)
+	}
+	return ParseSimple(v.Major()+1, 0, 0)
+}
+
+// Computes the exclusive upper bound for the npm-style "~" operator:
+// bumps the minor version unless only the major version was given.
+func tildeUpperBound(v *Version) *Version {
+	if definedPrecision(v) >= 2 {
+		return ParseSimple(v.Major(), v.Minor()+1, 0)
+	}
+	return ParseSimple(v.Major()+1, 0, 0)
+}
+
+// Computes the exclusive upper bound for the caret "^" operator, including
+// the usual 0.x special cases.
+func caretUpperBound(v *Version) *Version {
+	major, minor, patch := v.Major(), v.Minor(), v.Patch()
+	switch {
+	case major > 0:
+		return ParseSimple(major+1, 0, 0)
+	case minor > 0:
+		return ParseSimple(0, minor+1, 0)
+	case definedPrecision(v) >= 3:
+		return ParseSimple(0, 0, patch+1)
+	case definedPrecision(v) == 2:
+		return ParseSimple(0, 1, 0)
+	default:
+		return ParseSimple(1, 0, 0)
+	}
+}
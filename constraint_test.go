@@ -0,0 +1,86 @@
+package gover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintOperators(t *testing.T) {
+	assert := assert.New(t)
+
+	cases := []struct {
+		constraint string
+		version    string
+		expect     bool
+	}{
+		{"=1.2.3", "1.2.3", true},
+		{"=1.2.3", "1.2.4", false},
+		{"1.2.3", "1.2.3", true}, // no operator defaults to "="
+		{"!=1.2.3", "1.2.4", true},
+		{"!=1.2.3", "1.2.3", false},
+		{">1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.3", false},
+		{">=1.2.3", "1.2.3", true},
+		{"<1.2.3", "1.2.2", true},
+		{"<=1.2.3", "1.2.3", true},
+		{"~>1.2.3", "1.2.9", true},
+		{"~>1.2.3", "1.3.0", false},
+		{"~>1.2", "1.9.9", true},
+		{"~>1.2", "2.0.0", false},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"~1.2", "1.2.9", true},
+		{"~1.2", "1.3.0", false},
+		{"~1", "1.9.9", true},
+		{"~1", "2.0.0", false},
+		{"^1.2.3", "1.9.9", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.3", true},
+		{"^0.0.3", "0.0.4", false},
+	}
+
+	for _, c := range cases {
+		constraint, err := ParseConstraint(c.constraint)
+		assert.NoError(err)
+		version := MustParseVersionFromRegex(c.version, RegexpSimple)
+		assert.Equal(c.expect, constraint.Check(version), "%s vs %s", c.constraint, c.version)
+	}
+}
+
+func TestConstraintSetAndConstraints(t *testing.T) {
+	assert := assert.New(t)
+
+	set, err := ParseConstraintSet(">=1.2.3,<2.0.0")
+	assert.NoError(err)
+	assert.True(set.Check(MustParseVersionFromRegex("1.5.0", RegexpSimple)))
+	assert.False(set.Check(MustParseVersionFromRegex("2.0.0", RegexpSimple)))
+	assert.Equal(">=1.2.3,<2.0.0", set.String())
+
+	constraints, err := ParseConstraints(">=2.0.0||<1.0.0")
+	assert.NoError(err)
+	assert.True(constraints.Check(MustParseVersionFromRegex("0.5.0", RegexpSimple)))
+	assert.True(constraints.Check(MustParseVersionFromRegex("3.0.0", RegexpSimple)))
+	assert.False(constraints.Check(MustParseVersionFromRegex("1.5.0", RegexpSimple)))
+	assert.Equal(">=2.0.0||<1.0.0", constraints.String())
+}
+
+func TestFindMatching(t *testing.T) {
+	assert := assert.New(t)
+
+	versions := []*Version{
+		MustParseVersionFromRegex("1.0.0", RegexpSimple),
+		MustParseVersionFromRegex("1.2.3", RegexpSimple),
+		MustParseVersionFromRegex("1.5.0", RegexpSimple),
+		MustParseVersionFromRegex("2.0.0", RegexpSimple),
+	}
+	constraint, err := ParseConstraint("~>1.2")
+	assert.NoError(err)
+
+	matching := FindMatching(versions, constraint)
+	assert.Len(matching, 2)
+	assert.Equal("1.2.3", matching[0].Raw)
+	assert.Equal("1.5.0", matching[1].Raw)
+}
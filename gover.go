@@ -10,13 +10,15 @@ import (
 	"strings"
 )
 
-// A simple regexp that matches one, two or three digits separated by a dot.
-// d(.d)(.d)
-var RegexpSimple *regexp.Regexp = regexp.MustCompile(`^(?P<d1>\d+)(?:\.(?P<d2>\d+))?(?:\.(?P<d3>\d+))?$`)
+// A simple regexp that matches one, two or three digits separated by a dot,
+// with an optional leading v/V.
+// v?d(.d)(.d)
+var RegexpSimple *regexp.Regexp = regexp.MustCompile(`^[vV]?(?P<d1>\d+)(?:\.(?P<d2>\d+))?(?:\.(?P<d3>\d+))?$`)
 
-// A regex that matches the semantic versioning pattern.
-// d.d.d(-s)(+s)
-var RegexpSemver *regexp.Regexp = regexp.MustCompile(`^(?P<d1>\d+)\.(?P<d2>\d+)\.(?P<d3>\d+)(?:-(?P<s4>[^+]+))?(?:\+(?P<s5>.*))?$`)
+// A regex that matches the semantic versioning pattern, with an optional
+// leading v/V.
+// v?d.d.d(-s)(+s)
+var RegexpSemver *regexp.Regexp = regexp.MustCompile(`^[vV]?(?P<d1>\d+)\.(?P<d2>\d+)\.(?P<d3>\d+)(?:-(?P<s4>[^+]+))?(?:\+(?P<s5>.*))?$`)
 
 // An empty version, can be used to find the max version of a list.
 var EmptyVersion *Version = &Version{}
@@ -27,8 +29,11 @@ var (
 
 // Type that represents a version object.
 type Version struct {
-	Raw      string
-	Segments []VersionSegment
+	Raw        string
+	Original   string
+	Segments   []VersionSegment
+	Prerelease []PrereleaseIdent
+	Build      []string
 }
 
 // A segment of the version, can either be a number or a text.
@@ -80,6 +85,58 @@ func (v *Version) Patch() int {
 	return 0
 }
 
+// Counts the segments of the version. With onlyDefined, only the segments
+// that were actually given a value (not IsNotDefined) are counted.
+func (v *Version) SegmentCount(onlyDefined bool) int {
+	if !onlyDefined {
+		return len(v.Segments)
+	}
+	count := 0
+	for _, segment := range v.Segments {
+		if !segment.IsNotDefined {
+			count++
+		}
+	}
+	return count
+}
+
+// Counts the segments of the version that were actually given a value.
+func (v *Version) DefinedSegmentCount() int {
+	return v.SegmentCount(true)
+}
+
+// Renders the "Major.Minor.Patch" core of the version, treating any missing
+// or non-numeric leading segment (and everything after it) as 0.
+func (v *Version) CoreVersion() string {
+	var values [3]int
+	for i := 0; i < len(values) && i < len(v.Segments); i++ {
+		segment := v.Segments[i]
+		if segment.IsText || segment.IsNotDefined {
+			break
+		}
+		values[i] = segment.Number
+	}
+	return fmt.Sprintf("%d.%d.%d", values[0], values[1], values[2])
+}
+
+// Renders the version as "vMAJOR.MINOR.PATCH[-pre][+build]", matching
+// golang.org/x/mod/semver's conventions, whether or not the input had a
+// leading v. The prerelease and build parts come from Prerelease and Build,
+// which only RegexpSemver parsing and the bump helpers fill in; text
+// segments captured by other regexps are not rendered.
+func (v *Version) Canonical() string {
+	canonical := fmt.Sprintf("v%d.%d.%d", v.Major(), v.Minor(), v.Patch())
+	if len(v.Prerelease) > 0 {
+		canonical += "-" + joinPrerelease(v.Prerelease)
+	}
+	if len(v.Build) > 0 {
+		canonical += "+" + strings.Join(v.Build, ".")
+	}
+	return canonical
+}
+
+// Compares two versions using today's lenient, case-insensitive segment
+// comparison. Use CompareSemver for strict SemVer 2.0.0 precedence.
 func Compare(a *Version, b *Version) int {
 	return a.CompareTo(b)
 }
@@ -116,6 +173,8 @@ func (a *Version) Equals(b *Version) bool {
 	return a.CompareTo(b) == 0
 }
 
+// Sorts the versions using today's lenient Compare. Use SortSemver for strict
+// SemVer 2.0.0 precedence.
 func Sort(versions []*Version) {
 	slices.SortStableFunc(versions, Compare)
 }
@@ -140,15 +199,19 @@ func FindMaxGeneric[T any](versions []T, getFunc func(x T) *Version, referenceVe
 				if referenceSegment.IsNotDefined {
 					continue
 				}
-				// Invalidate if the number does not match
-				if referenceSegment.Number != versionSegment.Number {
+				// Invalidate if the candidate leaves this segment undefined
+				// or its number does not match
+				if versionSegment.IsNotDefined || referenceSegment.Number != versionSegment.Number {
 					isValid = false
 					break
 				}
 			}
 		}
 		if isValid {
-			if max == nil || version.GreaterThan(max) {
+			// On a tie, prefer the candidate that spells out more segments
+			// explicitly (e.g. "2.0.0" over "2.0" over "2")
+			if max == nil || version.GreaterThan(max) ||
+				(version.CompareTo(max) == 0 && version.DefinedSegmentCount() > max.DefinedSegmentCount()) {
 				max = version
 				maxObject = v
 			}
@@ -213,6 +276,10 @@ func ParseVersionFromRegex(versionString string, versionRegexp *regexp.Regexp) (
 	// Build a map with index and the segments
 	insertMap := map[int]VersionSegment{}
 	for k, v := range matchMap {
+		if k == "raw" {
+			// Not a segment, just the override for Raw handled below
+			continue
+		}
 		// Get the index of the current segment being processed
 		index, err := strconv.Atoi(k[1:])
 		if err != nil {
@@ -243,7 +310,13 @@ func ParseVersionFromRegex(versionString string, versionRegexp *regexp.Regexp) (
 	}
 
 	// Add the segments in the correct order
-	parsedVersion := &Version{Raw: versionString}
+	raw := versionString
+	if rawValue, ok := matchMap["raw"]; ok {
+		raw = rawValue
+	} else if versionRegexp == RegexpSimple || versionRegexp == RegexpSemver {
+		raw = stripLeadingV(raw)
+	}
+	parsedVersion := &Version{Raw: raw, Original: versionString}
 	index := 1
 	for {
 		if value, ok := insertMap[index]; !ok {
@@ -253,6 +326,11 @@ func ParseVersionFromRegex(versionString string, versionRegexp *regexp.Regexp) (
 		}
 		index++
 	}
+	// Populate the structured prerelease/build data used by CompareSemver
+	if versionRegexp == RegexpSemver {
+		parsedVersion.Prerelease = parsePrerelease(matchMap["s4"])
+		parsedVersion.Build = parseBuild(matchMap["s5"])
+	}
 	// Return it
 	return parsedVersion, nil
 }
@@ -283,11 +361,17 @@ func findNamedMatches(regex *regexp.Regexp, str string, includeNotMatchedOptiona
 	}
 	subexpNames := regex.SubexpNames()
 	results := map[string]string{}
-	// Loop thru the subexp names (skipping the first empty one)
+	// Loop thru the subexp names (skipping the first empty one). The "raw"
+	// group is a meta-capture, not a segment, so it doesn't consume a
+	// position when auto-naming the unnamed groups around it.
+	position := 0
 	for i, name := range (subexpNames)[1:] {
 		if name == "" {
 			// No name, so automatically give it a name
-			name = fmt.Sprintf("p%d", (i + 1))
+			position++
+			name = fmt.Sprintf("p%d", position)
+		} else if name != "raw" {
+			position++
 		}
 		startIndex := match[i*2+2]
 		endIndex := match[i*2+3]
@@ -305,6 +389,16 @@ func findNamedMatches(regex *regexp.Regexp, str string, includeNotMatchedOptiona
 	return results
 }
 
+// Strips a leading v/V prefix, but only when immediately followed by a
+// digit, so that it only strips the version prefix supported by
+// RegexpSimple/RegexpSemver and not an unrelated custom-regexp match.
+func stripLeadingV(value string) string {
+	if len(value) > 1 && (value[0] == 'v' || value[0] == 'V') && value[1] >= '0' && value[1] <= '9' {
+		return value[1:]
+	}
+	return value
+}
+
 // Converts a string to a segment
 func buildSegmentFromString(value string) VersionSegment {
 	// First try to convert to integer
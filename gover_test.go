@@ -340,3 +340,21 @@ func TestRaw(t *testing.T) {
 		assert.True(version.Equals(ParseSimple(4, 5, 6)))
 	}
 }
+
+func TestLeadingVPrefix(t *testing.T) {
+	assert := assert.New(t)
+
+	version := MustParseVersionFromRegex("v1.2.3-rc.1+build.5", RegexpSemver)
+	assert.Equal("v1.2.3-rc.1+build.5", version.Original)
+	assert.Equal("1.2.3-rc.1+build.5", version.Raw)
+	assert.Equal("v1.2.3-rc.1+build.5", version.Canonical())
+
+	simple := MustParseVersionFromRegex("V2.0", RegexpSimple)
+	assert.Equal("V2.0", simple.Original)
+	assert.Equal("2.0", simple.Raw)
+
+	noPrefix := MustParseVersionFromRegex("1.2.3", RegexpSemver)
+	assert.Equal("1.2.3", noPrefix.Original)
+	assert.Equal("1.2.3", noPrefix.Raw)
+	assert.Equal("v1.2.3", noPrefix.Canonical())
+}
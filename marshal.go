@@ -0,0 +1,74 @@
+package gover
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+// The regexp used to parse a version when unmarshalling from JSON, text or
+// SQL without an explicit parser. Override it (or call SetDefaultRegexp) to
+// register a custom regexp once and get transparent (de)serialization
+// everywhere.
+var DefaultParseRegexp *regexp.Regexp = RegexpSemver
+
+// Overrides DefaultParseRegexp.
+func SetDefaultRegexp(versionRegexp *regexp.Regexp) {
+	DefaultParseRegexp = versionRegexp
+}
+
+// Marshals the version to its raw string form.
+func (v *Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Raw)
+}
+
+// Unmarshals the version from its raw string form using DefaultParseRegexp.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	parsed, err := ParseVersionFromRegex(raw, DefaultParseRegexp)
+	if err != nil {
+		return err
+	}
+	*v = *parsed
+	return nil
+}
+
+// Marshals the version to its raw string form.
+func (v *Version) MarshalText() ([]byte, error) {
+	return []byte(v.Raw), nil
+}
+
+// Unmarshals the version from its raw string form using DefaultParseRegexp.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := ParseVersionFromRegex(string(text), DefaultParseRegexp)
+	if err != nil {
+		return err
+	}
+	*v = *parsed
+	return nil
+}
+
+// Implements driver.Valuer, storing the version as its raw string form.
+func (v *Version) Value() (driver.Value, error) {
+	return v.Raw, nil
+}
+
+// Implements sql.Scanner, parsing the version from a string or []byte column
+// using DefaultParseRegexp.
+func (v *Version) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*v = Version{}
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(value))
+	case []byte:
+		return v.UnmarshalText(value)
+	default:
+		return fmt.Errorf("failed scanning version: unsupported type %T", src)
+	}
+}
@@ -0,0 +1,68 @@
+package gover
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionJSONRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	type wrapper struct {
+		Version *Version `json:"version"`
+	}
+
+	original := wrapper{Version: MustParseVersionFromRegex("1.2.3-rc.1", RegexpSemver)}
+	data, err := json.Marshal(original)
+	assert.NoError(err)
+	assert.Equal(`{"version":"1.2.3-rc.1"}`, string(data))
+
+	var decoded wrapper
+	assert.NoError(json.Unmarshal(data, &decoded))
+	assert.Equal("1.2.3-rc.1", decoded.Version.Raw)
+	assert.True(decoded.Version.Equals(original.Version))
+}
+
+func TestVersionTextAndSQLRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	version := MustParseVersionFromRegex("1.2.3", RegexpSemver)
+
+	text, err := version.MarshalText()
+	assert.NoError(err)
+	assert.Equal("1.2.3", string(text))
+
+	var fromText Version
+	assert.NoError(fromText.UnmarshalText(text))
+	assert.Equal("1.2.3", fromText.Raw)
+
+	value, err := version.Value()
+	assert.NoError(err)
+	assert.Equal("1.2.3", value)
+
+	var fromSQL Version
+	assert.NoError(fromSQL.Scan("1.2.3"))
+	assert.Equal("1.2.3", fromSQL.Raw)
+	assert.NoError(fromSQL.Scan([]byte("1.2.4")))
+	assert.Equal("1.2.4", fromSQL.Raw)
+	assert.NoError(fromSQL.Scan(nil))
+	assert.Equal("", fromSQL.Raw)
+}
+
+func TestVersionUnmarshalWithCustomDefaultRegexp(t *testing.T) {
+	assert := assert.New(t)
+
+	previous := DefaultParseRegexp
+	defer SetDefaultRegexp(previous)
+
+	// d.d.d(_d)-d
+	reg := regexp.MustCompile(`^(?P<d1>\d+)\.(?P<d2>\d+)\.(?P<d3>\d+)(?:_(?P<d4>\d+))?-(?P<d5>\d+)$`)
+	SetDefaultRegexp(reg)
+
+	var version Version
+	assert.NoError(version.UnmarshalText([]byte("1.8.0_332-1")))
+	assert.Equal("1.8.0_332-1", version.Raw)
+}
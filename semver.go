@@ -0,0 +1,110 @@
+package gover
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// A single dot-separated identifier of a prerelease tag, e.g. "alpha" or "1"
+// in "1.0.0-alpha.1". Numeric identifiers compare numerically and always
+// rank below alphanumeric identifiers, per SemVer 2.0.0.
+type PrereleaseIdent struct {
+	Text      string
+	Number    int
+	IsNumeric bool
+}
+
+// Parses a version string using the strict SemVer 2.0.0 regexp, populating
+// Prerelease and Build alongside the regular segments.
+func ParseSemver(versionString string) (*Version, error) {
+	return ParseVersionFromRegex(versionString, RegexpSemver)
+}
+
+// Compares two versions using strict SemVer 2.0.0 precedence: major, minor
+// and patch are compared numerically, a version without a prerelease
+// outranks one with a prerelease, prerelease identifiers are compared
+// left-to-right, and build metadata is ignored.
+func CompareSemver(a *Version, b *Version) int {
+	if c := cmp.Compare(a.Major(), b.Major()); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Minor(), b.Minor()); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Patch(), b.Patch()); c != 0 {
+		return c
+	}
+	if len(a.Prerelease) == 0 && len(b.Prerelease) != 0 {
+		return 1
+	}
+	if len(a.Prerelease) != 0 && len(b.Prerelease) == 0 {
+		return -1
+	}
+	minLen := min(len(a.Prerelease), len(b.Prerelease))
+	for i := 0; i < minLen; i++ {
+		if c := comparePrereleaseIdent(a.Prerelease[i], b.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a.Prerelease), len(b.Prerelease))
+}
+
+// Sorts the versions using strict SemVer 2.0.0 precedence, see CompareSemver.
+func SortSemver(versions []*Version) {
+	slices.SortStableFunc(versions, CompareSemver)
+}
+
+func comparePrereleaseIdent(a PrereleaseIdent, b PrereleaseIdent) int {
+	if a.IsNumeric && b.IsNumeric {
+		return cmp.Compare(a.Number, b.Number)
+	}
+	if a.IsNumeric != b.IsNumeric {
+		if a.IsNumeric {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a.Text, b.Text)
+}
+
+// Splits a raw "-"-prefixed prerelease string like "alpha.1" into its
+// dot-separated identifiers.
+func parsePrerelease(raw string) []PrereleaseIdent {
+	if raw == "" {
+		return nil
+	}
+	parts := strings.Split(raw, ".")
+	idents := make([]PrereleaseIdent, len(parts))
+	for i, part := range parts {
+		if n, err := strconv.Atoi(part); err == nil {
+			idents[i] = PrereleaseIdent{Number: n, IsNumeric: true}
+		} else {
+			idents[i] = PrereleaseIdent{Text: part}
+		}
+	}
+	return idents
+}
+
+// Splits a raw "+"-prefixed build string like "build.5" into its
+// dot-separated identifiers.
+func parseBuild(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	return strings.Split(raw, ".")
+}
+
+// Joins prerelease identifiers back into their dot-separated string form.
+func joinPrerelease(idents []PrereleaseIdent) string {
+	parts := make([]string, len(idents))
+	for i, ident := range idents {
+		if ident.IsNumeric {
+			parts[i] = strconv.Itoa(ident.Number)
+		} else {
+			parts[i] = ident.Text
+		}
+	}
+	return strings.Join(parts, ".")
+}
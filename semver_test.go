@@ -0,0 +1,45 @@
+package gover
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareSemverPrecedence(t *testing.T) {
+	assert := assert.New(t)
+
+	versionListSorted := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+	}
+
+	versionsRandomized := []*Version{}
+	for _, v := range versionListSorted {
+		versionsRandomized = append(versionsRandomized, MustParseVersionFromRegex(v, RegexpSemver))
+	}
+	rand.Shuffle(len(versionsRandomized), func(i, j int) {
+		versionsRandomized[i], versionsRandomized[j] = versionsRandomized[j], versionsRandomized[i]
+	})
+
+	SortSemver(versionsRandomized)
+
+	for i, version := range versionsRandomized {
+		assert.Equal(versionListSorted[i], version.Raw)
+	}
+}
+
+func TestCompareSemverIgnoresBuild(t *testing.T) {
+	assert := assert.New(t)
+
+	a := MustParseVersionFromRegex("1.2.3+build.1", RegexpSemver)
+	b := MustParseVersionFromRegex("1.2.3+build.2", RegexpSemver)
+	assert.Equal(0, CompareSemver(a, b))
+}